@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sovereign/x/duty/types"
+)
+
+var errNoActiveValidators = errors.New("no bonded validators to assign duty to")
+
+// AssignDuty deterministically assigns the pending cross-chain message
+// (route, msgID) to a validator in the active set, weighted by voting
+// power. The assignment is a hash-based rotation seeded by the route and
+// msgID so that every full node computes the same assignment without
+// needing an on-chain VRF: each validator's cumulative voting-power range
+// is checked against hash(route, msgID) mod totalPower.
+func (k Keeper) AssignDuty(ctx sdk.Context, route types.Route, msgID uint64) (sdk.ValAddress, error) {
+	validators := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if len(validators) == 0 {
+		return nil, errNoActiveValidators
+	}
+
+	var totalPower int64
+	for _, val := range validators {
+		totalPower += val.GetConsensusPower(sdk.DefaultPowerReduction)
+	}
+	if totalPower == 0 {
+		return nil, errNoActiveValidators
+	}
+
+	target := dutySeed(route, msgID) % uint64(totalPower)
+
+	var cumulative int64
+	for _, val := range validators {
+		cumulative += val.GetConsensusPower(sdk.DefaultPowerReduction)
+		if target < uint64(cumulative) {
+			valAddr, err := sdk.ValAddressFromBech32(val.GetOperator().String())
+			if err != nil {
+				return nil, err
+			}
+
+			duty := types.Duty{
+				Route:            route,
+				MsgID:            msgID,
+				AssignedVal:      valAddr,
+				AssignedAtHeight: ctx.BlockHeight(),
+			}
+			k.SetPendingDuty(ctx, duty)
+			return valAddr, nil
+		}
+	}
+
+	// target is always below totalPower, which the loop above reaches, so
+	// this fallback to the last validator only runs if that invariant breaks.
+	last := validators[len(validators)-1]
+	valAddr, err := sdk.ValAddressFromBech32(last.GetOperator().String())
+	if err != nil {
+		return nil, err
+	}
+	duty := types.Duty{
+		Route:            route,
+		MsgID:            msgID,
+		AssignedVal:      valAddr,
+		AssignedAtHeight: ctx.BlockHeight(),
+	}
+	k.SetPendingDuty(ctx, duty)
+	return valAddr, nil
+}
+
+// dutySeed derives a uniformly distributed uint64 from route and msgID,
+// rotating the assignment as msgID advances.
+func dutySeed(route types.Route, msgID uint64) uint64 {
+	h := sha256.New()
+	h.Write([]byte(route.Origin))
+	h.Write([]byte{0x00})
+	h.Write([]byte(route.Destination))
+	h.Write([]byte{0x00})
+	var msgIDBytes [8]byte
+	binary.BigEndian.PutUint64(msgIDBytes[:], msgID)
+	h.Write(msgIDBytes[:])
+	sum := h.Sum(nil)
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// SetPendingDuty persists a pending duty assignment.
+func (k Keeper) SetPendingDuty(ctx sdk.Context, duty types.Duty) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.PendingDutyKey(duty.AssignedVal, duty.Route, duty.MsgID)
+	store.Set(key, k.cdc.MustMarshal(&duty))
+}
+
+// RemovePendingDuty retires a duty once it has been acknowledged as relayed.
+func (k Keeper) RemovePendingDuty(ctx sdk.Context, valAddr sdk.ValAddress, route types.Route, msgID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingDutyKey(valAddr, route, msgID))
+}
+
+// GetPendingDuty returns a single pending duty, if it exists.
+func (k Keeper) GetPendingDuty(ctx sdk.Context, valAddr sdk.ValAddress, route types.Route, msgID uint64) (types.Duty, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingDutyKey(valAddr, route, msgID))
+	if bz == nil {
+		return types.Duty{}, false
+	}
+	var duty types.Duty
+	k.cdc.MustUnmarshal(bz, &duty)
+	return duty, true
+}
+
+// QueryDuties returns all duties currently pending against valAddr.
+func (k Keeper) QueryDuties(ctx sdk.Context, valAddr sdk.ValAddress) []types.Duty {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingDutyValidatorPrefix(valAddr))
+	defer iterator.Close()
+
+	var duties []types.Duty
+	for ; iterator.Valid(); iterator.Next() {
+		var duty types.Duty
+		k.cdc.MustUnmarshal(iterator.Value(), &duty)
+		duties = append(duties, duty)
+	}
+	return duties
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	evidenceexported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
+
+	"sovereign/x/duty/types"
+)
+
+// EvidenceRouter builds the x/evidence Router for the duty module's two
+// evidence routes, for app.go to pass to the evidence module's keeper
+// alongside the routes registered by other modules.
+func (k Keeper) EvidenceRouter() evidencetypes.Router {
+	return evidencetypes.NewRouter().
+		AddRoute(types.RouteRelayerMissed, k.HandleRelayerMissedEvidence).
+		AddRoute(types.RouteRelayerInvalid, k.HandleRelayerInvalidEvidence)
+}
+
+// HandleRelayerMissedEvidence is registered with the x/evidence router under
+// types.RouteRelayerMissed. It verifies OriginProof (Merkle inclusion on the
+// origin chain) and DestNonInclusionProof (absence proof on the destination
+// chain at DestHeight), then slashes and jails the assigned validator and
+// credits ev.Reporter's RewardPool share.
+func (k Keeper) HandleRelayerMissedEvidence(ctx sdk.Context, evidence evidenceexported.Evidence) error {
+	ev, ok := evidence.(*types.RelayerMissedEvidence)
+	if !ok {
+		return fmt.Errorf("expected RelayerMissedEvidence, got %T", evidence)
+	}
+
+	if err := k.lightClient.VerifyInclusion(ctx, ev.DutyRoute.Origin, ev.OriginHeight, ev.OriginProof); err != nil {
+		return fmt.Errorf("origin inclusion proof failed: %w", err)
+	}
+	if err := k.lightClient.VerifyNonInclusion(ctx, ev.DutyRoute.Destination, ev.DestHeight, ev.DestNonInclusionProof); err != nil {
+		return fmt.Errorf("destination non-inclusion proof failed: %w", err)
+	}
+
+	return k.slashAndReward(ctx, ev.AssignedVal, ev.Reporter, k.GetParams(ctx).SlashFractionMissed)
+}
+
+// HandleRelayerInvalidEvidence is registered with the x/evidence router
+// under types.RouteRelayerInvalid. It verifies DestFailureProof (a failure
+// receipt proof on the destination chain at DestHeight), then slashes and
+// jails the assigned validator and credits ev.Reporter's RewardPool share.
+func (k Keeper) HandleRelayerInvalidEvidence(ctx sdk.Context, evidence evidenceexported.Evidence) error {
+	ev, ok := evidence.(*types.RelayerInvalidEvidence)
+	if !ok {
+		return fmt.Errorf("expected RelayerInvalidEvidence, got %T", evidence)
+	}
+
+	if err := k.lightClient.VerifyInclusion(ctx, ev.DutyRoute.Destination, ev.DestHeight, ev.DestFailureProof); err != nil {
+		return fmt.Errorf("destination failure-receipt proof failed: %w", err)
+	}
+
+	return k.slashAndReward(ctx, ev.AssignedVal, ev.Reporter, k.GetParams(ctx).SlashFractionInvalid)
+}
+
+// slashAndReward slashes and jails valAddr by slashFraction for
+// Params.JailWindowBlocks, then credits reporter with the governance-tunable
+// ReporterRewardShare of the slashed amount.
+func (k Keeper) slashAndReward(ctx sdk.Context, valAddr sdk.ValAddress, reporter sdk.AccAddress, slashFraction sdk.Dec) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return fmt.Errorf("no validator found for address %s", valAddr)
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return fmt.Errorf("failed to derive consensus address for validator %s: %w", valAddr, err)
+	}
+
+	power := validator.GetConsensusPower(sdk.DefaultPowerReduction)
+	slashed := k.stakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, slashFraction)
+	k.stakingKeeper.Jail(ctx, consAddr)
+	k.SetJailedUntil(ctx, valAddr, ctx.BlockHeight()+k.GetParams(ctx).JailWindowBlocks)
+
+	reporterShare := sdk.NewDecFromInt(slashed).Mul(k.GetParams(ctx).ReporterRewardShare).TruncateInt()
+	if reporterShare.IsPositive() {
+		k.AddReporterReward(ctx, reporter, reporterShare)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"duty_evidence_confirmed",
+			sdk.NewAttribute("val_addr", valAddr.String()),
+			sdk.NewAttribute("reporter", reporter.String()),
+			sdk.NewAttribute("slashed", slashed.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"sovereign/x/duty/types"
+)
+
+// TestSlashAndRewardUsesValidatorConsAddr pins the Round 2 review fix:
+// slashAndReward must derive the ConsAddress passed to Slash/Jail from
+// validator.GetConsAddr(), not from sdk.ConsAddress(valAddr) (an invalid
+// cast of operator-address bytes into the consensus-address namespace).
+func TestSlashAndRewardUsesValidatorConsAddr(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator-operator-1"))
+	validator := newValidator(t, valAddr, 100)
+	wantConsAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	require.NotEqual(t, sdk.ConsAddress(valAddr), wantConsAddr, "test fixture must exercise a validator whose cons addr differs from its operator bytes")
+
+	staking := &stubStakingKeeper{
+		byOperator: map[string]stakingtypes.Validator{valAddr.String(): validator},
+	}
+	k, ctx := newTestKeeper(t, staking)
+
+	reporter := sdk.AccAddress([]byte("reporter-account-1"))
+	require.NoError(t, k.slashAndReward(ctx, valAddr, reporter, sdk.NewDecWithPrec(1, 2)))
+
+	require.Equal(t, []sdk.ConsAddress{wantConsAddr}, staking.slashed)
+	require.Equal(t, []sdk.ConsAddress{wantConsAddr}, staking.jailed)
+}
+
+// TestSlashAndRewardUnknownValidator ensures a missing validator is reported
+// as an error rather than silently slashing a bogus ConsAddress.
+func TestSlashAndRewardUnknownValidator(t *testing.T) {
+	staking := &stubStakingKeeper{byOperator: map[string]stakingtypes.Validator{}}
+	k, ctx := newTestKeeper(t, staking)
+
+	valAddr := sdk.ValAddress([]byte("unknown-validator"))
+	reporter := sdk.AccAddress([]byte("reporter-account-1"))
+	err := k.slashAndReward(ctx, valAddr, reporter, sdk.NewDecWithPrec(1, 2))
+	require.Error(t, err)
+	require.Empty(t, staking.slashed)
+}
+
+// TestHandleRelayerMissedEvidenceUsesOriginHeight pins the OriginHeight fix:
+// VerifyInclusion on the origin chain must be called with the evidence's
+// own OriginHeight, not DestHeight (the two proofs are over different
+// chains and heights).
+func TestHandleRelayerMissedEvidenceUsesOriginHeight(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator-operator-2"))
+	validator := newValidator(t, valAddr, 100)
+	staking := &stubStakingKeeper{
+		byOperator: map[string]stakingtypes.Validator{valAddr.String(): validator},
+	}
+	var gotOriginHeight int64
+	lightClient := &recordingLightClient{onVerifyInclusion: func(chainID string, height int64, proof []byte) error {
+		gotOriginHeight = height
+		return nil
+	}}
+	k, ctx := newTestKeeper(t, staking)
+	k.lightClient = lightClient
+
+	ev := &types.RelayerMissedEvidence{
+		DutyRoute:             types.Route{Origin: "chain-a", Destination: "chain-b"},
+		MsgID:                 7,
+		AssignedVal:           valAddr,
+		OriginProof:           []byte("origin-proof"),
+		OriginHeight:          42,
+		DestNonInclusionProof: []byte("dest-proof"),
+		DestHeight:            99,
+		Reporter:              sdk.AccAddress([]byte("reporter-account-2")),
+	}
+	require.NoError(t, k.HandleRelayerMissedEvidence(ctx, ev))
+	require.EqualValues(t, 42, gotOriginHeight)
+}
+
+type recordingLightClient struct {
+	onVerifyInclusion func(chainID string, height int64, proof []byte) error
+}
+
+func (r *recordingLightClient) VerifyInclusion(ctx sdk.Context, chainID string, height int64, proof []byte) error {
+	return r.onVerifyInclusion(chainID, height, proof)
+}
+
+func (r *recordingLightClient) VerifyNonInclusion(ctx sdk.Context, chainID string, height int64, proof []byte) error {
+	return nil
+}
+
+func (r *recordingLightClient) VerifyHeader(ctx sdk.Context, chainID string, height int64, blockHash []byte) error {
+	return nil
+}
+
+var _ types.LightClientVerifier = (*recordingLightClient)(nil)
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sovereign/x/duty/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the duty QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+var _ types.QueryServer = queryServer{}
+
+func (k queryServer) Duties(goCtx context.Context, req *types.QueryDutiesRequest) (*types.QueryDutiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDutiesResponse{Duties: k.QueryDuties(ctx, valAddr)}, nil
+}
+
+func (k queryServer) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k queryServer) HeartbeatNonce(goCtx context.Context, req *types.QueryHeartbeatNonceRequest) (*types.QueryHeartbeatNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHeartbeatNonceResponse{Nonce: k.GetLastHeartbeatNonce(ctx, valAddr)}, nil
+}
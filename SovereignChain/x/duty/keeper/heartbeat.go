@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sovereign/x/duty/types"
+)
+
+// GetLastHeartbeatNonce returns the last accepted heartbeat nonce for
+// valAddr, or 0 if it has never submitted one.
+func (k Keeper) GetLastHeartbeatNonce(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HeartbeatNonceKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastHeartbeatNonce persists nonce as valAddr's last accepted heartbeat
+// nonce.
+func (k Keeper) SetLastHeartbeatNonce(ctx sdk.Context, valAddr sdk.ValAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, nonce)
+	store.Set(types.HeartbeatNonceKey(valAddr), bz)
+}
+
+// GetLiveness returns valAddr's consecutive valid-heartbeat counter.
+func (k Keeper) GetLiveness(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LivenessKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// IncrementLiveness advances valAddr's consecutive valid-heartbeat counter
+// by one and returns the new value.
+func (k Keeper) IncrementLiveness(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	next := k.GetLiveness(ctx, valAddr) + 1
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, next)
+	store.Set(types.LivenessKey(valAddr), bz)
+	return next
+}
+
+// ResetLiveness zeroes valAddr's consecutive valid-heartbeat counter, e.g.
+// after a missed-heartbeat period breaks the streak.
+func (k Keeper) ResetLiveness(ctx sdk.Context, valAddr sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.LivenessKey(valAddr))
+}
+
+// GetLastHeartbeatTime returns the unix time of valAddr's last accepted
+// heartbeat, or ok=false if it has never submitted one.
+func (k Keeper) GetLastHeartbeatTime(ctx sdk.Context, valAddr sdk.ValAddress) (t int64, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastHeartbeatTimeKey(valAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetLastHeartbeatTime persists t as valAddr's last accepted heartbeat time.
+func (k Keeper) SetLastHeartbeatTime(ctx sdk.Context, valAddr sdk.ValAddress, t int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(t))
+	store.Set(types.LastHeartbeatTimeKey(valAddr), bz)
+}
+
+// GetMissedCount returns valAddr's consecutive missed-heartbeat-period
+// counter.
+func (k Keeper) GetMissedCount(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MissedCountKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// IncrementMissedCount advances valAddr's consecutive missed-heartbeat-period
+// counter by one and returns the new value.
+func (k Keeper) IncrementMissedCount(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	next := k.GetMissedCount(ctx, valAddr) + 1
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, next)
+	store.Set(types.MissedCountKey(valAddr), bz)
+	return next
+}
+
+// ResetMissedCount zeroes valAddr's consecutive missed-heartbeat-period
+// counter, e.g. after a successful heartbeat or a jailing consequence.
+func (k Keeper) ResetMissedCount(ctx sdk.Context, valAddr sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MissedCountKey(valAddr))
+}
+
+// ProcessMissedHeartbeats scans bonded validators and, for any whose last
+// accepted heartbeat is older than Params.HeartbeatPeriodSeconds, breaks
+// their liveness streak and advances their missed-heartbeat-period counter.
+// Once that counter reaches Params.MissedDutyThreshold the validator is
+// jailed for Params.JailWindowBlocks, the same consequence confirmed relay
+// evidence carries, and the counter resets.
+func (k Keeper) ProcessMissedHeartbeats(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	now := ctx.BlockTime().Unix()
+
+	for _, validator := range k.stakingKeeper.GetBondedValidatorsByPower(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator().String())
+		if err != nil {
+			continue
+		}
+
+		lastHeartbeat, found := k.GetLastHeartbeatTime(ctx, valAddr)
+		if !found || now-lastHeartbeat <= params.HeartbeatPeriodSeconds {
+			continue
+		}
+
+		k.ResetLiveness(ctx, valAddr)
+		missed := k.IncrementMissedCount(ctx, valAddr)
+		if missed < params.MissedDutyThreshold {
+			continue
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			continue
+		}
+		k.stakingKeeper.Jail(ctx, consAddr)
+		k.SetJailedUntil(ctx, valAddr, ctx.BlockHeight()+params.JailWindowBlocks)
+		k.ResetMissedCount(ctx, valAddr)
+	}
+}
+
+// VerifyHeartbeatPayload enforces replay protection and attestation
+// validity for payload, as submitted by valAddr:
+//
+//   - Nonce must be strictly greater than the last accepted nonce.
+//   - ExpiresAtHeight must not have already passed.
+//   - Every attestation's BlockHash must match the registered light client's
+//     view of ChainID's header at Height.
+//
+// On success it persists the new nonce and advances the liveness counter.
+func (k Keeper) VerifyHeartbeatPayload(ctx sdk.Context, valAddr sdk.ValAddress, payload types.HeartbeatPayload) error {
+	lastNonce := k.GetLastHeartbeatNonce(ctx, valAddr)
+	if payload.Nonce <= lastNonce {
+		return fmt.Errorf("heartbeat nonce %d is not greater than last accepted nonce %d", payload.Nonce, lastNonce)
+	}
+	if payload.ExpiresAtHeight < ctx.BlockHeight() {
+		return fmt.Errorf("heartbeat expired at height %d, current height is %d", payload.ExpiresAtHeight, ctx.BlockHeight())
+	}
+
+	for chainID, attestation := range payload.Attestations {
+		if err := k.lightClient.VerifyHeader(ctx, chainID, attestation.Height, attestation.BlockHash); err != nil {
+			return fmt.Errorf("attestation for chain %s failed: %w", chainID, err)
+		}
+	}
+
+	k.SetLastHeartbeatNonce(ctx, valAddr, payload.Nonce)
+	k.SetLastHeartbeatTime(ctx, valAddr, ctx.BlockTime().Unix())
+	k.IncrementLiveness(ctx, valAddr)
+	k.ResetMissedCount(ctx, valAddr)
+
+	return nil
+}
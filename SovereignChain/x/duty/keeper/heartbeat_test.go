@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"sovereign/x/duty/types"
+)
+
+// TestProcessMissedHeartbeatsJailsUsingConsAddr pins the Round 2 review fix:
+// once a validator crosses Params.MissedDutyThreshold, ProcessMissedHeartbeats
+// must jail it via validator.GetConsAddr(), not sdk.ConsAddress(valAddr).
+func TestProcessMissedHeartbeatsJailsUsingConsAddr(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator-operator-3"))
+	validator := newValidator(t, valAddr, 100)
+	wantConsAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	staking := &stubStakingKeeper{bonded: []stakingtypes.Validator{validator}}
+	k, ctx := newTestKeeper(t, staking)
+
+	params := types.DefaultParams()
+	params.MissedDutyThreshold = 1
+	params.HeartbeatPeriodSeconds = 30
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// No heartbeat has ever been recorded, and the block time is always
+	// "now - 0s" more recent than an unset last-heartbeat time would imply,
+	// so seed a stale LastHeartbeatTime far enough in the past to trip the
+	// missed check on the very first scan.
+	k.SetLastHeartbeatTime(ctx, valAddr, ctx.BlockTime().Unix()-1000)
+
+	k.ProcessMissedHeartbeats(ctx)
+
+	require.Equal(t, []sdk.ConsAddress{wantConsAddr}, staking.jailed)
+	require.EqualValues(t, 0, k.GetMissedCount(ctx, valAddr), "missed count should reset once the jail consequence fires")
+}
+
+// TestProcessMissedHeartbeatsSkipsBelowThreshold ensures a single missed
+// period below MissedDutyThreshold does not jail the validator.
+func TestProcessMissedHeartbeatsSkipsBelowThreshold(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator-operator-4"))
+	validator := newValidator(t, valAddr, 100)
+	staking := &stubStakingKeeper{bonded: []stakingtypes.Validator{validator}}
+	k, ctx := newTestKeeper(t, staking)
+
+	params := types.DefaultParams()
+	params.MissedDutyThreshold = 3
+	params.HeartbeatPeriodSeconds = 30
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.SetLastHeartbeatTime(ctx, valAddr, ctx.BlockTime().Unix()-1000)
+	k.ProcessMissedHeartbeats(ctx)
+
+	require.Empty(t, staking.jailed)
+	require.EqualValues(t, 1, k.GetMissedCount(ctx, valAddr))
+}
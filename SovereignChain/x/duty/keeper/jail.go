@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sovereign/x/duty/types"
+)
+
+// SetJailedUntil records that valAddr was jailed by this module (for
+// confirmed evidence or missed heartbeats) and should be unjailed once the
+// chain reaches untilHeight.
+func (k Keeper) SetJailedUntil(ctx sdk.Context, valAddr sdk.ValAddress, untilHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(untilHeight))
+	store.Set(types.JailedUntilKey(valAddr), bz)
+}
+
+// UnjailExpired scans validators with a recorded SetJailedUntil height and
+// unjails any whose Params.JailWindowBlocks has elapsed, bounding the
+// indefinite x/staking jail to the governance-tunable window.
+func (k Keeper) UnjailExpired(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.JailedUntilKeyPrefix)
+	defer iterator.Close()
+
+	height := ctx.BlockHeight()
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		untilHeight := int64(binary.BigEndian.Uint64(iterator.Value()))
+		if height < untilHeight {
+			continue
+		}
+		valAddr := iterator.Key()[len(types.JailedUntilKeyPrefix):]
+		expired = append(expired, append([]byte{}, valAddr...))
+	}
+
+	for _, valAddr := range expired {
+		if validator, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+			if consAddr, err := validator.GetConsAddr(); err == nil {
+				k.stakingKeeper.Unjail(ctx, consAddr)
+			}
+		}
+		store.Delete(types.JailedUntilKey(valAddr))
+	}
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"sovereign/x/duty/types"
+)
+
+// TestUnjailExpiredUsesValidatorConsAddr pins the Round 2 review fix:
+// UnjailExpired must unjail via validator.GetConsAddr(), not
+// sdk.ConsAddress(valAddr).
+func TestUnjailExpiredUsesValidatorConsAddr(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator-operator-5"))
+	validator := newValidator(t, valAddr, 100)
+	wantConsAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	staking := &stubStakingKeeper{
+		byOperator: map[string]stakingtypes.Validator{valAddr.String(): validator},
+	}
+	k, ctx := newTestKeeper(t, staking)
+
+	k.SetJailedUntil(ctx, valAddr, ctx.BlockHeight()-1)
+	k.UnjailExpired(ctx)
+
+	require.Equal(t, []sdk.ConsAddress{wantConsAddr}, staking.unjailed)
+
+	store := ctx.KVStore(k.storeKey)
+	require.Nil(t, store.Get(types.JailedUntilKey(valAddr)), "expired entry should be deleted regardless of unjail outcome")
+}
+
+// TestUnjailExpiredSkipsNotYetDue ensures a jail whose window hasn't
+// elapsed is left alone.
+func TestUnjailExpiredSkipsNotYetDue(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator-operator-6"))
+	validator := newValidator(t, valAddr, 100)
+	staking := &stubStakingKeeper{
+		byOperator: map[string]stakingtypes.Validator{valAddr.String(): validator},
+	}
+	k, ctx := newTestKeeper(t, staking)
+
+	k.SetJailedUntil(ctx, valAddr, ctx.BlockHeight()+100)
+	k.UnjailExpired(ctx)
+
+	require.Empty(t, staking.unjailed)
+	store := ctx.KVStore(k.storeKey)
+	require.NotNil(t, store.Get(types.JailedUntilKey(valAddr)))
+}
+
+// TestUnjailExpiredMissingValidatorStillClearsEntry covers the case where
+// the validator record is gone (e.g. fully unbonded) by the time its jail
+// window elapses: UnjailExpired must not panic and must still clear the
+// stale JailedUntil entry.
+func TestUnjailExpiredMissingValidatorStillClearsEntry(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator-operator-7"))
+	staking := &stubStakingKeeper{byOperator: map[string]stakingtypes.Validator{}}
+	k, ctx := newTestKeeper(t, staking)
+
+	k.SetJailedUntil(ctx, valAddr, ctx.BlockHeight()-1)
+	require.NotPanics(t, func() { k.UnjailExpired(ctx) })
+
+	require.Empty(t, staking.unjailed)
+	store := ctx.KVStore(k.storeKey)
+	require.Nil(t, store.Get(types.JailedUntilKey(valAddr)))
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"sovereign/x/duty/types"
+)
+
+// Keeper manages the duty module's state: registered sidecars, pending duty
+// assignments, heartbeat liveness tracking, and evidence/slashing bookkeeping.
+type Keeper struct {
+	cdc            codec.BinaryCodec
+	storeKey       storetypes.StoreKey
+	stakingKeeper  types.StakingKeeper
+	evidenceKeeper types.EvidenceKeeper
+	lightClient    types.LightClientVerifier
+	authority      string
+}
+
+// NewKeeper constructs a new duty Keeper. authority is the module account or
+// governance address permitted to submit MsgUpdateParams.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	stakingKeeper types.StakingKeeper,
+	evidenceKeeper types.EvidenceKeeper,
+	lightClient types.LightClientVerifier,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		stakingKeeper:  stakingKeeper,
+		evidenceKeeper: evidenceKeeper,
+		lightClient:    lightClient,
+		authority:      authority,
+	}
+}
+
+// GetAuthority returns the x/duty module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetSidecars persists the relayer and validator public keys registered for
+// valAddr.
+func (k Keeper) SetSidecars(ctx sdk.Context, valAddr sdk.ValAddress, sidecars types.Sidecars) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SidecarKey(valAddr), k.cdc.MustMarshal(&sidecars))
+}
+
+// GetSidecars returns the sidecars registered for valAddr, if any.
+func (k Keeper) GetSidecars(ctx sdk.Context, valAddr sdk.ValAddress) (types.Sidecars, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SidecarKey(valAddr))
+	if bz == nil {
+		return types.Sidecars{}, false
+	}
+	var sidecars types.Sidecars
+	k.cdc.MustUnmarshal(bz, &sidecars)
+	return sidecars, true
+}
+
+// GetRelayerPubKey unpacks and returns the relayer pubkey registered for
+// valAddr.
+func (k Keeper) GetRelayerPubKey(ctx sdk.Context, valAddr sdk.ValAddress) (cryptotypes.PubKey, error) {
+	sidecars, found := k.GetSidecars(ctx, valAddr)
+	if !found {
+		return nil, fmt.Errorf("no sidecars registered for validator %s", valAddr)
+	}
+	pk, ok := sidecars.RelayerPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("registered relayer pubkey for validator %s is not a cryptotypes.PubKey", valAddr)
+	}
+	return pk, nil
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidenceexported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"sovereign/x/duty/types"
+)
+
+// stubStakingKeeper is a hand-written stand-in for types.StakingKeeper. It
+// records every Slash/Jail/Unjail call so tests can assert on the
+// ConsAddress each was actually invoked with, which is the thing Round 2
+// review caught going wrong (sdk.ConsAddress(valAddr) instead of
+// validator.GetConsAddr()).
+type stubStakingKeeper struct {
+	bonded     []stakingtypes.Validator
+	byOperator map[string]stakingtypes.Validator
+
+	jailed   []sdk.ConsAddress
+	unjailed []sdk.ConsAddress
+	slashed  []sdk.ConsAddress
+}
+
+func (s *stubStakingKeeper) GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator {
+	return s.bonded
+}
+
+func (s *stubStakingKeeper) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	val, ok := s.byOperator[addr.String()]
+	return val, ok
+}
+
+func (s *stubStakingKeeper) ValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) stakingtypes.ValidatorI {
+	return nil
+}
+
+func (s *stubStakingKeeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor sdk.Dec) sdk.Int {
+	s.slashed = append(s.slashed, consAddr)
+	return sdk.NewInt(1000)
+}
+
+func (s *stubStakingKeeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	s.jailed = append(s.jailed, consAddr)
+}
+
+func (s *stubStakingKeeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	s.unjailed = append(s.unjailed, consAddr)
+}
+
+var _ types.StakingKeeper = (*stubStakingKeeper)(nil)
+
+type stubEvidenceKeeper struct{}
+
+func (stubEvidenceKeeper) SubmitEvidence(ctx sdk.Context, evidence evidenceexported.Evidence) error {
+	return nil
+}
+
+var _ types.EvidenceKeeper = stubEvidenceKeeper{}
+
+type stubLightClient struct{}
+
+func (stubLightClient) VerifyInclusion(ctx sdk.Context, chainID string, height int64, proof []byte) error {
+	return nil
+}
+
+func (stubLightClient) VerifyNonInclusion(ctx sdk.Context, chainID string, height int64, proof []byte) error {
+	return nil
+}
+
+func (stubLightClient) VerifyHeader(ctx sdk.Context, chainID string, height int64, blockHash []byte) error {
+	return nil
+}
+
+var _ types.LightClientVerifier = stubLightClient{}
+
+// newValidator builds a bonded stakingtypes.Validator for valAddr whose
+// ConsensusPubkey is packed in-process (via codectypes.NewAnyWithValue,
+// which sets the Any's cached value directly), so GetConsAddr() resolves
+// without needing an interface registry round-trip.
+func newValidator(t *testing.T, valAddr sdk.ValAddress, power int64) stakingtypes.Validator {
+	t.Helper()
+	var pk cryptotypes.PubKey = ed25519.GenPrivKey().PubKey()
+	val, err := stakingtypes.NewValidator(valAddr, pk, stakingtypes.Description{})
+	require.NoError(t, err)
+	val.Status = stakingtypes.Bonded
+	val.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	val.DelegatorShares = sdk.NewDecFromInt(val.Tokens)
+	return val
+}
+
+// newTestKeeper wires a Keeper against a fresh in-memory IAVL store and the
+// stub expected-keepers above, with Params seeded to types.DefaultParams().
+func newTestKeeper(t *testing.T, staking types.StakingKeeper) (Keeper, sdk.Context) {
+	t.Helper()
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{Height: 1, Time: time.Now()}, false, log.NewNopLogger()).
+		WithBlockHeight(1).
+		WithBlockTime(time.Now())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := NewKeeper(cdc, storeKey, staking, stubEvidenceKeeper{}, stubLightClient{}, "authority")
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	return k, ctx
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoproto "github.com/gogo/protobuf/proto"
+
+	"sovereign/x/duty/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the duty MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) RegisterSidecars(goCtx context.Context, msg *types.MsgRegisterSidecars) (*types.MsgRegisterSidecarsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayerPubKey, ok := msg.RelayerPubKeyAsPubKey()
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "relayer public key did not unpack to a cryptotypes.PubKey")
+	}
+	relayerAddr := sdk.AccAddress(relayerPubKey.Address())
+
+	params := k.GetParams(ctx)
+	for _, blocked := range params.BlockedRelayerAddresses {
+		if blocked == relayerAddr.String() {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "relayer address is barred from registering sidecars")
+		}
+	}
+
+	sidecars := types.Sidecars{
+		RelayerPubKey:   *msg.RelayerPubKey,
+		ValidatorPubKey: *msg.ValidatorPubKey,
+	}
+	k.SetSidecars(ctx, msg.ValAddr, sidecars)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"register_sidecars",
+			sdk.NewAttribute("val_addr", msg.ValAddr.String()),
+		),
+	)
+
+	return &types.MsgRegisterSidecarsResponse{}, nil
+}
+
+func (k msgServer) Heartbeat(goCtx context.Context, msg *types.MsgHeartbeat) (*types.MsgHeartbeatResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayerPubKey, err := k.GetRelayerPubKey(ctx, msg.ValAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	if !sdk.AccAddress(relayerPubKey.Address()).Equals(msg.Signer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signer is not the registered relayer for this validator")
+	}
+
+	// The relayer signs the canonical marshaled bytes of Payload directly,
+	// independent of the outer Msg's own encoding, so the keeper can verify
+	// the signature without depending on SIGN_MODE.
+	signBytes, err := gogoproto.Marshal(&msg.Payload)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "failed to marshal heartbeat payload")
+	}
+	if !relayerPubKey.VerifySignature(signBytes, msg.Sig) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "heartbeat signature does not match registered relayer pubkey")
+	}
+
+	if err := k.VerifyHeartbeatPayload(ctx, msg.ValAddr, msg.Payload); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"heartbeat",
+			sdk.NewAttribute("val_addr", msg.ValAddr.String()),
+		),
+	)
+
+	return &types.MsgHeartbeatResponse{}, nil
+}
+
+func (k msgServer) ReportMissed(goCtx context.Context, msg *types.MsgReportMissed) (*types.MsgReportMissedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	evidence := &types.RelayerMissedEvidence{
+		DutyRoute:             msg.Route,
+		MsgID:                 msg.MsgID,
+		AssignedVal:           msg.AssignedVal,
+		OriginProof:           msg.OriginProof,
+		OriginHeight:          msg.OriginHeight,
+		DestNonInclusionProof: msg.DestNonInclusionProof,
+		DestHeight:            msg.DestHeight,
+		Reporter:              msg.Signer,
+	}
+	if err := k.evidenceKeeper.SubmitEvidence(ctx, evidence); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgReportMissedResponse{}, nil
+}
+
+func (k msgServer) ReportInvalid(goCtx context.Context, msg *types.MsgReportInvalid) (*types.MsgReportInvalidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	evidence := &types.RelayerInvalidEvidence{
+		DutyRoute:        msg.Route,
+		MsgID:            msg.MsgID,
+		AssignedVal:      msg.AssignedVal,
+		DestFailureProof: msg.DestFailureProof,
+		DestHeight:       msg.DestHeight,
+		Reporter:         msg.Signer,
+	}
+	if err := k.evidenceKeeper.SubmitEvidence(ctx, evidence); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgReportInvalidResponse{}, nil
+}
+
+func (k msgServer) AckRelayed(goCtx context.Context, msg *types.MsgAckRelayed) (*types.MsgAckRelayedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayerPubKey, err := k.GetRelayerPubKey(ctx, msg.AssignedVal)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	if !sdk.AccAddress(relayerPubKey.Address()).Equals(msg.Signer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signer is not the registered relayer for this validator")
+	}
+
+	duty, found := k.GetPendingDuty(ctx, msg.AssignedVal, msg.Route, msg.MsgID)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "no pending duty for this (route, msg_id, validator)")
+	}
+
+	k.RemovePendingDuty(ctx, duty.AssignedVal, duty.Route, duty.MsgID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ack_relayed",
+			sdk.NewAttribute("val_addr", msg.AssignedVal.String()),
+			sdk.NewAttribute("msg_id", fmt.Sprintf("%d", msg.MsgID)),
+		),
+	)
+
+	return &types.MsgAckRelayedResponse{}, nil
+}
+
+func (k msgServer) AssignDuty(goCtx context.Context, msg *types.MsgAssignDuty) (*types.MsgAssignDutyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.lightClient.VerifyInclusion(ctx, msg.Route.Origin, msg.OriginHeight, msg.OriginProof); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("origin inclusion proof failed: %s", err))
+	}
+
+	valAddr, err := k.Keeper.AssignDuty(ctx, msg.Route, msg.MsgID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"assign_duty",
+			sdk.NewAttribute("val_addr", valAddr.String()),
+			sdk.NewAttribute("msg_id", fmt.Sprintf("%d", msg.MsgID)),
+		),
+	)
+
+	return &types.MsgAssignDutyResponse{AssignedVal: valAddr}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority.String() != k.GetAuthority() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
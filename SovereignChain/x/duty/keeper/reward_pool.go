@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sovereign/x/duty/types"
+)
+
+// AddReporterReward credits reporter's ledger balance in the RewardPool by
+// amount (denominated in the staking bond denom, since that's what gets
+// slashed). This is informational bookkeeping only: StakingKeeper.Slash
+// burns the slashed stake rather than routing it anywhere this module could
+// pay out from, so there is no backing balance to actually transfer. Once
+// slashed funds (or a governance-funded pool) have a real destination this
+// module can draw from, this ledger becomes the basis for a MsgClaimReward
+// paying out via bank transfer; until then it only records what a reporter
+// is owed.
+func (k Keeper) AddReporterReward(ctx sdk.Context, reporter sdk.AccAddress, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.RewardPoolKey(reporter)
+
+	existing := k.GetReporterReward(ctx, reporter)
+	updated := existing.Add(amount)
+
+	bz, err := updated.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// GetReporterReward returns reporter's accumulated, unclaimed RewardPool
+// balance.
+func (k Keeper) GetReporterReward(ctx sdk.Context, reporter sdk.AccAddress) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RewardPoolKey(reporter))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	evidenceexported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// RegisterLegacyAminoCodec registers the duty module's types for amino JSON
+// (still needed for the legacy CLI `--generate-only` path and SIGN_MODE_LEGACY_AMINO_JSON).
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterSidecars{}, "duty/MsgRegisterSidecars", nil)
+	cdc.RegisterConcrete(&MsgHeartbeat{}, "duty/MsgHeartbeat", nil)
+	cdc.RegisterConcrete(&MsgReportMissed{}, "duty/MsgReportMissed", nil)
+	cdc.RegisterConcrete(&MsgReportInvalid{}, "duty/MsgReportInvalid", nil)
+	cdc.RegisterConcrete(&MsgAckRelayed{}, "duty/MsgAckRelayed", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "duty/MsgUpdateParams", nil)
+}
+
+// RegisterInterfaces registers the module's interface types.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterSidecars{},
+		&MsgHeartbeat{},
+		&MsgReportMissed{},
+		&MsgReportInvalid{},
+		&MsgAckRelayed{},
+		&MsgUpdateParams{},
+	)
+	// RelayerPubKey/ValidatorPubKey on MsgRegisterSidecars are packed as Any
+	// over the same cryptotypes.PubKey interface the SDK already registers
+	// for Validator.ConsensusPubkey, so no extra interface registration is
+	// needed here beyond making sure the concrete key types are known.
+	cryptocodec.RegisterInterfaces(registry)
+
+	registry.RegisterImplementations((*evidenceexported.Evidence)(nil),
+		&RelayerMissedEvidence{},
+		&RelayerInvalidEvidence{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// ModuleCdc is an amino codec over a fresh LegacyAmino; no message types are
+// registered on it, and genesis (de)serialization in module.go uses the
+// JSONCodec passed to the AppModule rather than ModuleCdc.
+var ModuleCdc = codec.NewAminoCodec(codec.NewLegacyAmino())
@@ -0,0 +1,957 @@
+package types
+
+import (
+	"fmt"
+	io "io"
+	math "math"
+	bits "math/bits"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+const (
+	// RouteRelayerMissed is the evidence route for a missed relaying duty.
+	RouteRelayerMissed = "relayer_missed"
+	// RouteRelayerInvalid is the evidence route for an invalid relay.
+	RouteRelayerInvalid = "relayer_invalid"
+
+	// TypeRelayerMissed is the evidence type reported in MsgReportMissed.
+	TypeRelayerMissed = "relayer_missed"
+	// TypeRelayerInvalid is the evidence type reported in MsgReportInvalid.
+	TypeRelayerInvalid = "relayer_invalid"
+)
+
+// RelayerMissedEvidence is submitted when a validator assigned a Duty never
+// relayed it: OriginProof shows the message was included on the origin
+// chain, and DestNonInclusionProof shows it was absent from the destination
+// chain's header at DestHeight.
+//
+// The field is named DutyRoute, not Route, because Route is also the name
+// of the exported.Evidence method below; Go does not allow a field and a
+// method of the same name on the same type.
+type RelayerMissedEvidence struct {
+	DutyRoute             Route          `json:"duty_route"`
+	MsgID                 uint64         `json:"msg_id"`
+	AssignedVal           sdk.ValAddress `json:"assigned_val"`
+	OriginProof           []byte         `json:"origin_proof"`
+	// OriginHeight is the origin chain height OriginProof was taken at.
+	// DestHeight cannot stand in for it: the two proofs are over different
+	// chains, observed at different heights.
+	OriginHeight          int64          `json:"origin_height"`
+	DestNonInclusionProof []byte         `json:"dest_non_inclusion_proof"`
+	DestHeight            int64          `json:"dest_height"`
+	// Reporter is the account that submitted the evidence, credited with a
+	// share of the slashed amount. It rides along on the evidence itself
+	// rather than as a Handler argument, since evidencetypes.Handler is
+	// func(sdk.Context, exported.Evidence) error.
+	Reporter sdk.AccAddress `json:"reporter"`
+}
+
+func (e *RelayerMissedEvidence) Reset()         { *e = RelayerMissedEvidence{} }
+func (e *RelayerMissedEvidence) String() string { return fmt.Sprintf("%+v", *e) }
+func (*RelayerMissedEvidence) ProtoMessage()    {}
+
+// Route returns the evidence route used to look up the registered Handler.
+func (e *RelayerMissedEvidence) Route() string { return RouteRelayerMissed }
+
+// Type returns the evidence type.
+func (e *RelayerMissedEvidence) Type() string { return TypeRelayerMissed }
+
+// Hash returns the identifying hash of the evidence, used for deduping.
+func (e *RelayerMissedEvidence) Hash() tmbytes.HexBytes {
+	return tmbytes.HexBytes(tmhash.Sum([]byte(fmt.Sprintf("%s/%s/%d/%s", e.DutyRoute.Origin, e.DutyRoute.Destination, e.MsgID, e.AssignedVal))))
+}
+
+// ValidateBasic performs stateless validation of the evidence.
+func (e *RelayerMissedEvidence) ValidateBasic() error {
+	if e.AssignedVal.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "assigned validator address cannot be empty")
+	}
+	if len(e.OriginProof) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "origin proof cannot be empty")
+	}
+	if e.OriginHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "origin height must be positive")
+	}
+	if len(e.DestNonInclusionProof) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination non-inclusion proof cannot be empty")
+	}
+	if e.DestHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination height must be positive")
+	}
+	return nil
+}
+
+// GetHeight returns the height at which the evidence occurred, i.e. the
+// destination chain height the non-inclusion proof is over.
+func (e *RelayerMissedEvidence) GetHeight() int64 { return e.DestHeight }
+
+// RelayerInvalidEvidence is submitted when a validator relayed a message
+// that the destination chain rejected: DestFailureProof is a failure
+// receipt proof over the destination chain at DestHeight.
+//
+// The field is named DutyRoute for the same reason as RelayerMissedEvidence.
+type RelayerInvalidEvidence struct {
+	DutyRoute        Route          `json:"duty_route"`
+	MsgID            uint64         `json:"msg_id"`
+	AssignedVal      sdk.ValAddress `json:"assigned_val"`
+	DestFailureProof []byte         `json:"dest_failure_proof"`
+	DestHeight       int64          `json:"dest_height"`
+	// Reporter is the account that submitted the evidence, credited with a
+	// share of the slashed amount.
+	Reporter sdk.AccAddress `json:"reporter"`
+}
+
+func (e *RelayerInvalidEvidence) Reset()         { *e = RelayerInvalidEvidence{} }
+func (e *RelayerInvalidEvidence) String() string { return fmt.Sprintf("%+v", *e) }
+func (*RelayerInvalidEvidence) ProtoMessage()    {}
+
+// Route returns the evidence route used to look up the registered Handler.
+func (e *RelayerInvalidEvidence) Route() string { return RouteRelayerInvalid }
+
+// Type returns the evidence type.
+func (e *RelayerInvalidEvidence) Type() string { return TypeRelayerInvalid }
+
+// Hash returns the identifying hash of the evidence, used for deduping.
+func (e *RelayerInvalidEvidence) Hash() tmbytes.HexBytes {
+	return tmbytes.HexBytes(tmhash.Sum([]byte(fmt.Sprintf("%s/%s/%d/%s", e.DutyRoute.Origin, e.DutyRoute.Destination, e.MsgID, e.AssignedVal))))
+}
+
+// ValidateBasic performs stateless validation of the evidence.
+func (e *RelayerInvalidEvidence) ValidateBasic() error {
+	if e.AssignedVal.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "assigned validator address cannot be empty")
+	}
+	if len(e.DestFailureProof) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination failure proof cannot be empty")
+	}
+	if e.DestHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination height must be positive")
+	}
+	return nil
+}
+
+// GetHeight returns the height at which the evidence occurred, i.e. the
+// destination chain height the failure-receipt proof is over.
+func (e *RelayerInvalidEvidence) GetHeight() int64 { return e.DestHeight }
+
+func init() {
+	proto.RegisterType((*RelayerMissedEvidence)(nil), "sovereign.duty.v1.RelayerMissedEvidence")
+	proto.RegisterType((*RelayerInvalidEvidence)(nil), "sovereign.duty.v1.RelayerInvalidEvidence")
+}
+
+// Marshal/Size/Unmarshal below are hand-written in the same
+// protoc-gen-gogo style as the rest of the package (see duty.pb.go): these
+// two types have no corresponding .proto message, since they are relayed
+// internally through evidencetypes.Handler and never cross the wire as a
+// standalone request/response, but they are still packed into Any by
+// x/evidence's MsgSubmitEvidence and therefore still need to satisfy
+// codec.ProtoMarshaler.
+
+func (m *RelayerMissedEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerMissedEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerMissedEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OriginHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.OriginHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DestHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.DestHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DestNonInclusionProof) > 0 {
+		i -= len(m.DestNonInclusionProof)
+		copy(dAtA[i:], m.DestNonInclusionProof)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.DestNonInclusionProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OriginProof) > 0 {
+		i -= len(m.OriginProof)
+		copy(dAtA[i:], m.OriginProof)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.OriginProof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AssignedVal) > 0 {
+		i -= len(m.AssignedVal)
+		copy(dAtA[i:], m.AssignedVal)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.AssignedVal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MsgID != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.MsgID))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.DutyRoute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerMissedEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DutyRoute.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.MsgID != 0 {
+		n += 1 + sovEvidence(uint64(m.MsgID))
+	}
+	l = len(m.AssignedVal)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.OriginProof)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.DestNonInclusionProof)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.DestHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.DestHeight))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.OriginHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.OriginHeight))
+	}
+	return n
+}
+
+func (m *RelayerMissedEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerMissedEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerMissedEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutyRoute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutyRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgID", wireType)
+			}
+			m.MsgID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedVal = append(m.AssignedVal[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssignedVal == nil {
+				m.AssignedVal = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginProof = append(m.OriginProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginProof == nil {
+				m.OriginProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestNonInclusionProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestNonInclusionProof = append(m.DestNonInclusionProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestNonInclusionProof == nil {
+				m.DestNonInclusionProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestHeight", wireType)
+			}
+			m.DestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginHeight", wireType)
+			}
+			m.OriginHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RelayerInvalidEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerInvalidEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerInvalidEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DestHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.DestHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DestFailureProof) > 0 {
+		i -= len(m.DestFailureProof)
+		copy(dAtA[i:], m.DestFailureProof)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.DestFailureProof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AssignedVal) > 0 {
+		i -= len(m.AssignedVal)
+		copy(dAtA[i:], m.AssignedVal)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.AssignedVal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MsgID != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.MsgID))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.DutyRoute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerInvalidEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DutyRoute.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.MsgID != 0 {
+		n += 1 + sovEvidence(uint64(m.MsgID))
+	}
+	l = len(m.AssignedVal)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.DestFailureProof)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.DestHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.DestHeight))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayerInvalidEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerInvalidEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerInvalidEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutyRoute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutyRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgID", wireType)
+			}
+			m.MsgID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedVal = append(m.AssignedVal[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssignedVal == nil {
+				m.AssignedVal = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestFailureProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestFailureProof = append(m.DestFailureProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestFailureProof == nil {
+				m.DestFailureProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestHeight", wireType)
+			}
+			m.DestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+
+func skipEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvidence = fmt.Errorf("proto: unexpected end of group")
+)
+
+var _ = math.Inf
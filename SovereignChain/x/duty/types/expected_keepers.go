@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidenceexported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected interface needed to read the active
+// validator set and its voting power for duty assignment, and to slash and
+// jail a validator once evidence is confirmed. Jail itself is indefinite;
+// the keeper bounds it to Params.JailWindowBlocks by recording an unjail
+// height and calling Unjail once EndBlock sees that height has passed (see
+// keeper/jail.go).
+type StakingKeeper interface {
+	GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator
+	// GetValidator looks up a validator by its operator (ValAddress), the
+	// same address duty assignment and evidence key off of. Its
+	// GetConsAddr() is the only correct way to derive the ConsAddress
+	// Slash/Jail/Unjail/ValidatorByConsAddr expect — consensus and operator
+	// addresses are distinct namespaces derived from different keys, so
+	// sdk.ConsAddress(valAddr) is never a valid substitute.
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	ValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) stakingtypes.ValidatorI
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor sdk.Dec) sdk.Int
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	Unjail(ctx sdk.Context, consAddr sdk.ConsAddress)
+}
+
+// EvidenceKeeper defines the expected interface for submitting verified
+// RelayerMissedEvidence/RelayerInvalidEvidence into x/evidence, which routes
+// it to the duty module's registered Handler.
+type EvidenceKeeper interface {
+	SubmitEvidence(ctx sdk.Context, evidence evidenceexported.Evidence) error
+}
+
+// LightClientVerifier abstracts over light-client header verification for a
+// registered chain, whether backed by IBC 02-client or a pluggable header
+// verifier for non-IBC chains (akin to how cross-chain relayers verify
+// Warp/attestation messages).
+type LightClientVerifier interface {
+	// VerifyInclusion checks that proof demonstrates the given key/value was
+	// included in chainID's state at height.
+	VerifyInclusion(ctx sdk.Context, chainID string, height int64, proof []byte) error
+	// VerifyNonInclusion checks that proof demonstrates absence of the given
+	// key from chainID's state at height.
+	VerifyNonInclusion(ctx sdk.Context, chainID string, height int64, proof []byte) error
+	// VerifyHeader checks that blockHash matches the header chainID committed
+	// to at height.
+	VerifyHeader(ctx sdk.Context, chainID string, height int64, blockHash []byte) error
+}
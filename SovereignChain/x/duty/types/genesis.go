@@ -0,0 +1,18 @@
+package types
+
+// GenesisState defines the x/duty module's genesis state.
+type GenesisState struct {
+	Params Params `json:"params"`
+}
+
+// DefaultGenesis returns the default x/duty GenesisState.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
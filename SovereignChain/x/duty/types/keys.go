@@ -0,0 +1,111 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "duty"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the duty module.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes for the duty module.
+var (
+	// SidecarKeyPrefix: SidecarKeyPrefix | valAddr -> relayer/validator pubkeys
+	SidecarKeyPrefix = []byte{0x01}
+
+	// PendingDutyKeyPrefix: PendingDutyKeyPrefix | valAddr | route.Origin | 0x00 | route.Destination | 0x00 | msgID -> Duty
+	PendingDutyKeyPrefix = []byte{0x02}
+
+	// RewardPoolKeyPrefix: RewardPoolKeyPrefix | reporterAddr -> accumulated reward amount (sdk.Int)
+	RewardPoolKeyPrefix = []byte{0x03}
+
+	// HeartbeatNonceKeyPrefix: HeartbeatNonceKeyPrefix | valAddr -> last accepted heartbeat nonce
+	HeartbeatNonceKeyPrefix = []byte{0x04}
+
+	// LivenessKeyPrefix: LivenessKeyPrefix | valAddr -> consecutive valid-heartbeat counter
+	LivenessKeyPrefix = []byte{0x05}
+
+	// ParamsKey: ParamsKey -> the module's current Params
+	ParamsKey = []byte{0x06}
+
+	// JailedUntilKeyPrefix: JailedUntilKeyPrefix | valAddr -> block height at
+	// which the validator's duty-module jailing (confirmed evidence or missed
+	// heartbeats) expires.
+	JailedUntilKeyPrefix = []byte{0x07}
+
+	// LastHeartbeatTimeKeyPrefix: LastHeartbeatTimeKeyPrefix | valAddr -> unix
+	// seconds of the last accepted heartbeat.
+	LastHeartbeatTimeKeyPrefix = []byte{0x08}
+
+	// MissedCountKeyPrefix: MissedCountKeyPrefix | valAddr -> consecutive
+	// missed-heartbeat-period counter, compared against
+	// Params.MissedDutyThreshold.
+	MissedCountKeyPrefix = []byte{0x09}
+)
+
+// RewardPoolKey returns the store key for a reporter's accumulated slashing
+// rewards.
+func RewardPoolKey(reporter []byte) []byte {
+	return append(RewardPoolKeyPrefix, reporter...)
+}
+
+// HeartbeatNonceKey returns the store key for a validator's last accepted
+// heartbeat nonce.
+func HeartbeatNonceKey(valAddr []byte) []byte {
+	return append(HeartbeatNonceKeyPrefix, valAddr...)
+}
+
+// LivenessKey returns the store key for a validator's consecutive
+// valid-heartbeat counter.
+func LivenessKey(valAddr []byte) []byte {
+	return append(LivenessKeyPrefix, valAddr...)
+}
+
+// SidecarKey returns the store key for a validator's registered sidecar.
+func SidecarKey(valAddr []byte) []byte {
+	return append(SidecarKeyPrefix, valAddr...)
+}
+
+// PendingDutyValidatorPrefix returns the iteration prefix for all duties
+// pending against valAddr.
+func PendingDutyValidatorPrefix(valAddr []byte) []byte {
+	return append(PendingDutyKeyPrefix, valAddr...)
+}
+
+// JailedUntilKey returns the store key for a validator's recorded unjail
+// height.
+func JailedUntilKey(valAddr []byte) []byte {
+	return append(JailedUntilKeyPrefix, valAddr...)
+}
+
+// LastHeartbeatTimeKey returns the store key for a validator's last accepted
+// heartbeat time.
+func LastHeartbeatTimeKey(valAddr []byte) []byte {
+	return append(LastHeartbeatTimeKeyPrefix, valAddr...)
+}
+
+// MissedCountKey returns the store key for a validator's consecutive
+// missed-heartbeat-period counter.
+func MissedCountKey(valAddr []byte) []byte {
+	return append(MissedCountKeyPrefix, valAddr...)
+}
+
+// PendingDutyKey returns the store key for a single pending duty.
+func PendingDutyKey(valAddr []byte, route Route, msgID uint64) []byte {
+	key := PendingDutyValidatorPrefix(valAddr)
+	key = append(key, []byte(route.Origin)...)
+	key = append(key, 0x00)
+	key = append(key, []byte(route.Destination)...)
+	key = append(key, 0x00)
+	return append(key, sdk.Uint64ToBigEndian(msgID)...)
+}
@@ -1,122 +1,146 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// Ensure the ADR-031 message types still satisfy sdk.Msg (ValidateBasic and
+// GetSigners), even though Route/Type/GetSignBytes are gone now that these
+// are routed through MsgServiceRouter instead of the legacy amino router.
 var (
 	_ sdk.Msg = &MsgRegisterSidecars{}
 	_ sdk.Msg = &MsgHeartbeat{}
 	_ sdk.Msg = &MsgReportMissed{}
 	_ sdk.Msg = &MsgReportInvalid{}
+	_ sdk.Msg = &MsgAckRelayed{}
+	_ sdk.Msg = &MsgAssignDuty{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
-// MsgRegisterSidecars defines a message to register relayer and validator public keys.
-type MsgRegisterSidecars struct {
-	ValAddr         sdk.ValAddress `json:"val_addr"`
-	RelayerPubKey   []byte         `json:"relayer_pub_key"`
-	ValidatorPubKey []byte         `json:"validator_pub_key"`
-}
-
-func NewMsgRegisterSidecars(valAddr sdk.ValAddress, relayerPubKey, validatorPubKey []byte) *MsgRegisterSidecars {
+// NewMsgRegisterSidecars packs relayerPubKey and validatorPubKey into Any so
+// any registered PubKey implementation (secp256k1, ed25519, sr25519) can be
+// carried by the message.
+func NewMsgRegisterSidecars(valAddr sdk.ValAddress, relayerPubKey, validatorPubKey cryptotypes.PubKey) (*MsgRegisterSidecars, error) {
+	relayerAny, err := codectypes.NewAnyWithValue(relayerPubKey)
+	if err != nil {
+		return nil, err
+	}
+	validatorAny, err := codectypes.NewAnyWithValue(validatorPubKey)
+	if err != nil {
+		return nil, err
+	}
 	return &MsgRegisterSidecars{
 		ValAddr:         valAddr,
-		RelayerPubKey:   relayerPubKey,
-		ValidatorPubKey: validatorPubKey,
-	}
+		RelayerPubKey:   relayerAny,
+		ValidatorPubKey: validatorAny,
+	}, nil
 }
 
-// Route returns the message route.
-func (msg MsgRegisterSidecars) Route() string { return RouterKey }
-
-// Type returns the message type.
-func (msg MsgRegisterSidecars) Type() string { return "register_sidecars" }
-
 // ValidateBasic performs basic validation of the message.
 func (msg MsgRegisterSidecars) ValidateBasic() error {
 	if msg.ValAddr.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator address cannot be empty")
 	}
+	if msg.RelayerPubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "relayer public key cannot be empty")
+	}
+	if msg.ValidatorPubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "validator public key cannot be empty")
+	}
 	return nil
 }
 
-// GetSignBytes returns the canonical byte representation of the message.
-func (msg MsgRegisterSidecars) GetSignBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
-}
-
 // GetSigners returns the signers of the message.
 func (msg MsgRegisterSidecars) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.ValAddr)}
 }
 
-// MsgHeartbeat defines a message to send a heartbeat.
-type MsgHeartbeat struct {
-	ValAddr         sdk.ValAddress `json:"val_addr"`
-	OriginHeightsJSON string         `json:"origin_heights_json"`
-	Sig             []byte         `json:"sig"`
+// RelayerPubKeyAsPubKey unpacks RelayerPubKey into a cryptotypes.PubKey.
+func (msg MsgRegisterSidecars) RelayerPubKeyAsPubKey() (cryptotypes.PubKey, bool) {
+	pk, ok := msg.RelayerPubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk, ok
 }
 
-func NewMsgHeartbeat(valAddr sdk.ValAddress, originHeightsJSON string, sig []byte) *MsgHeartbeat {
-	return &MsgHeartbeat{
-		ValAddr:         valAddr,
-		OriginHeightsJSON: originHeightsJSON,
-		Sig:             sig,
+// ValidatorPubKeyAsPubKey unpacks ValidatorPubKey into a cryptotypes.PubKey.
+func (msg MsgRegisterSidecars) ValidatorPubKeyAsPubKey() (cryptotypes.PubKey, bool) {
+	pk, ok := msg.ValidatorPubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk, ok
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (msg MsgRegisterSidecars) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var relayerPubKey, validatorPubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(msg.RelayerPubKey, &relayerPubKey); err != nil {
+		return err
 	}
+	return unpacker.UnpackAny(msg.ValidatorPubKey, &validatorPubKey)
 }
 
-// Route returns the message route.
-func (msg MsgHeartbeat) Route() string { return RouterKey }
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage. Without
+// it, ProtoCodec.Unmarshal never populates RelayerPubKey/ValidatorPubKey's
+// cached value when Sidecars is read back from the KVStore, and
+// GetCachedValue().(cryptotypes.PubKey) fails on every lookup after the
+// registering tx.
+func (s Sidecars) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var relayerPubKey, validatorPubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(&s.RelayerPubKey, &relayerPubKey); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(&s.ValidatorPubKey, &validatorPubKey)
+}
 
-// Type returns the message type.
-func (msg MsgHeartbeat) Type() string { return "heartbeat" }
+// NewMsgHeartbeat builds a MsgHeartbeat from a canonical payload and its
+// signature over payload's marshaled bytes. signer is the account that
+// broadcasts the tx, i.e. the registered relayer address for valAddr, not
+// the validator's own address.
+func NewMsgHeartbeat(valAddr sdk.ValAddress, payload HeartbeatPayload, sig []byte, signer sdk.AccAddress) *MsgHeartbeat {
+	return &MsgHeartbeat{
+		ValAddr: valAddr,
+		Payload: payload,
+		Sig:     sig,
+		Signer:  signer,
+	}
+}
 
 // ValidateBasic performs basic validation of the message.
 func (msg MsgHeartbeat) ValidateBasic() error {
 	if msg.ValAddr.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator address cannot be empty")
 	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer address cannot be empty")
+	}
+	if len(msg.Sig) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "heartbeat payload signature cannot be empty")
+	}
 	return nil
 }
 
-// GetSignBytes returns the canonical byte representation of the message.
-func (msg MsgHeartbeat) GetSignBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
-}
-
-// GetSigners returns the signers of the message.
+// GetSigners returns the signers of the message. This is the broadcasting
+// relayer account (Signer), not ValAddr — the validator itself never signs
+// heartbeat txs, its registered relayer sidecar does, and the ante handler's
+// signature check must agree with whichever key actually signed.
 func (msg MsgHeartbeat) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{sdk.AccAddress(msg.ValAddr)}
-}
-
-// MsgReportMissed defines a message to report a missed duty.
-type MsgReportMissed struct {
-	Route                 Route          `json:"route"`
-	MsgID                 uint64         `json:"msg_id"`
-	AssignedVal           sdk.ValAddress `json:"assigned_val"`
-	OriginProof           []byte         `json:"origin_proof"`
-	DestNonInclusionProof []byte         `json:"dest_non_inclusion_proof"`
-	Signer                sdk.AccAddress `json:"signer"`
+	return []sdk.AccAddress{msg.Signer}
 }
 
-func NewMsgReportMissed(route Route, msgID uint64, assignedVal sdk.ValAddress, originProof, destNonInclusionProof []byte, signer sdk.AccAddress) *MsgReportMissed {
+// NewMsgReportMissed creates a new MsgReportMissed.
+func NewMsgReportMissed(route Route, msgID uint64, assignedVal sdk.ValAddress, originProof []byte, originHeight int64, destNonInclusionProof []byte, destHeight int64, signer sdk.AccAddress) *MsgReportMissed {
 	return &MsgReportMissed{
 		Route:                 route,
 		MsgID:                 msgID,
 		AssignedVal:           assignedVal,
 		OriginProof:           originProof,
+		OriginHeight:          originHeight,
 		DestNonInclusionProof: destNonInclusionProof,
+		DestHeight:            destHeight,
 		Signer:                signer,
 	}
 }
 
-// Route returns the message route.
-func (msg MsgReportMissed) Route() string { return RouterKey }
-
-// Type returns the message type.
-func (msg MsgReportMissed) Type() string { return "report_missed" }
-
 // ValidateBasic performs basic validation of the message.
 func (msg MsgReportMissed) ValidateBasic() error {
 	if msg.AssignedVal.Empty() {
@@ -125,46 +149,74 @@ func (msg MsgReportMissed) ValidateBasic() error {
 	if msg.Signer.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer address cannot be empty")
 	}
+	if len(msg.OriginProof) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "origin proof cannot be empty")
+	}
+	if msg.OriginHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "origin height must be positive")
+	}
+	if len(msg.DestNonInclusionProof) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination non-inclusion proof cannot be empty")
+	}
+	if msg.DestHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination height must be positive")
+	}
 	return nil
 }
 
-// GetSignBytes returns the canonical byte representation of the message.
-func (msg MsgReportMissed) GetSignBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
-}
-
 // GetSigners returns the signers of the message.
 func (msg MsgReportMissed) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
 
-// MsgReportInvalid defines a message to report an invalid relay.
-type MsgReportInvalid struct {
-	Route            Route          `json:"route"`
-	MsgID            uint64         `json:"msg_id"`
-	AssignedVal      sdk.ValAddress `json:"assigned_val"`
-	DestFailureProof []byte         `json:"dest_failure_proof"`
-	Signer           sdk.AccAddress `json:"signer"`
-}
-
-func NewMsgReportInvalid(route Route, msgID uint64, assignedVal sdk.ValAddress, destFailureProof []byte, signer sdk.AccAddress) *MsgReportInvalid {
+// NewMsgReportInvalid creates a new MsgReportInvalid.
+func NewMsgReportInvalid(route Route, msgID uint64, assignedVal sdk.ValAddress, destFailureProof []byte, destHeight int64, signer sdk.AccAddress) *MsgReportInvalid {
 	return &MsgReportInvalid{
 		Route:            route,
 		MsgID:            msgID,
 		AssignedVal:      assignedVal,
 		DestFailureProof: destFailureProof,
+		DestHeight:       destHeight,
 		Signer:           signer,
 	}
 }
 
-// Route returns the message route.
-func (msg MsgReportInvalid) Route() string { return RouterKey }
+// ValidateBasic performs basic validation of the message.
+func (msg MsgReportInvalid) ValidateBasic() error {
+	if msg.AssignedVal.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "assigned validator address cannot be empty")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer address cannot be empty")
+	}
+	if len(msg.DestFailureProof) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination failure proof cannot be empty")
+	}
+	if msg.DestHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "destination height must be positive")
+	}
+	return nil
+}
 
-// Type returns the message type.
-func (msg MsgReportInvalid) Type() string { return "report_invalid" }
+// GetSigners returns the signers of the message.
+func (msg MsgReportInvalid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// NewMsgAckRelayed creates a new MsgAckRelayed. signer is the account that
+// broadcasts the tx, i.e. the registered relayer address for assignedVal,
+// not the validator's own address.
+func NewMsgAckRelayed(route Route, msgID uint64, assignedVal sdk.ValAddress, signer sdk.AccAddress) *MsgAckRelayed {
+	return &MsgAckRelayed{
+		Route:       route,
+		MsgID:       msgID,
+		AssignedVal: assignedVal,
+		Signer:      signer,
+	}
+}
 
 // ValidateBasic performs basic validation of the message.
-func (msg MsgReportInvalid) ValidateBasic() error {
+func (msg MsgAckRelayed) ValidateBasic() error {
 	if msg.AssignedVal.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "assigned validator address cannot be empty")
 	}
@@ -174,12 +226,63 @@ func (msg MsgReportInvalid) ValidateBasic() error {
 	return nil
 }
 
-// GetSignBytes returns the canonical byte representation of the message.
-func (msg MsgReportInvalid) GetSignBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+// GetSigners returns the signers of the message. This is the broadcasting
+// relayer account (Signer), not AssignedVal — see MsgHeartbeat.GetSigners.
+func (msg MsgAckRelayed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// NewMsgAssignDuty creates a new MsgAssignDuty. originProof must demonstrate,
+// via VerifyInclusion against route.Origin at originHeight, that the
+// cross-chain message msgID actually exists — assignment is otherwise
+// unbounded and anyone could pollute QueryDuties with assignments for
+// messages that were never sent.
+func NewMsgAssignDuty(route Route, msgID uint64, originProof []byte, originHeight int64, signer sdk.AccAddress) *MsgAssignDuty {
+	return &MsgAssignDuty{
+		Route:        route,
+		MsgID:        msgID,
+		OriginProof:  originProof,
+		OriginHeight: originHeight,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic performs basic validation of the message.
+func (msg MsgAssignDuty) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer address cannot be empty")
+	}
+	if len(msg.OriginProof) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "origin proof cannot be empty")
+	}
+	if msg.OriginHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "origin height must be positive")
+	}
+	return nil
 }
 
 // GetSigners returns the signers of the message.
-func (msg MsgReportInvalid) GetSigners() []sdk.AccAddress {
+func (msg MsgAssignDuty) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams.
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic performs basic validation of the message.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority address cannot be empty")
+	}
+	return msg.Params.Validate()
+}
+
+// GetSigners returns the signers of the message.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ParamStoreKeyHeartbeatPeriodSeconds, etc. are the legacy x/params subspace
+// keys for each field of Params, kept around for param-store migration
+// compatibility alongside the keeper's direct Get/SetParams.
+var (
+	ParamStoreKeyHeartbeatPeriodSeconds  = []byte("HeartbeatPeriodSeconds")
+	ParamStoreKeyAssignmentWindowBlocks  = []byte("AssignmentWindowBlocks")
+	ParamStoreKeyMissedDutyThreshold     = []byte("MissedDutyThreshold")
+	ParamStoreKeySlashFractionMissed     = []byte("SlashFractionMissed")
+	ParamStoreKeySlashFractionInvalid    = []byte("SlashFractionInvalid")
+	ParamStoreKeyReporterRewardShare     = []byte("ReporterRewardShare")
+	ParamStoreKeyJailWindowBlocks        = []byte("JailWindowBlocks")
+	ParamStoreKeyBlockedRelayerAddresses = []byte("BlockedRelayerAddresses")
+)
+
+// ParamKeyTable returns the legacy x/params key table for Params, kept for
+// modules that still migrate through a param subspace.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements paramtypes.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyHeartbeatPeriodSeconds, &p.HeartbeatPeriodSeconds, validatePositiveInt64),
+		paramtypes.NewParamSetPair(ParamStoreKeyAssignmentWindowBlocks, &p.AssignmentWindowBlocks, validatePositiveInt64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMissedDutyThreshold, &p.MissedDutyThreshold, validateMissedDutyThreshold),
+		paramtypes.NewParamSetPair(ParamStoreKeySlashFractionMissed, &p.SlashFractionMissed, validateFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeySlashFractionInvalid, &p.SlashFractionInvalid, validateFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeyReporterRewardShare, &p.ReporterRewardShare, validateFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeyJailWindowBlocks, &p.JailWindowBlocks, validatePositiveInt64),
+		paramtypes.NewParamSetPair(ParamStoreKeyBlockedRelayerAddresses, &p.BlockedRelayerAddresses, validateBlockedRelayerAddresses),
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(
+	heartbeatPeriodSeconds, assignmentWindowBlocks int64,
+	missedDutyThreshold uint64,
+	slashFractionMissed, slashFractionInvalid, reporterRewardShare sdk.Dec,
+	jailWindowBlocks int64,
+	blockedRelayerAddresses []string,
+) Params {
+	return Params{
+		HeartbeatPeriodSeconds:  heartbeatPeriodSeconds,
+		AssignmentWindowBlocks:  assignmentWindowBlocks,
+		MissedDutyThreshold:     missedDutyThreshold,
+		SlashFractionMissed:     slashFractionMissed,
+		SlashFractionInvalid:    slashFractionInvalid,
+		ReporterRewardShare:     reporterRewardShare,
+		JailWindowBlocks:        jailWindowBlocks,
+		BlockedRelayerAddresses: blockedRelayerAddresses,
+	}
+}
+
+// DefaultParams returns the default x/duty Params.
+func DefaultParams() Params {
+	return NewParams(
+		30,                       // heartbeat every 30s
+		100,                      // assignment window of 100 blocks
+		3,                        // jailed on the 3rd consecutive missed heartbeat period
+		sdk.NewDecWithPrec(1, 3), // 0.1% slash for a missed duty
+		sdk.NewDecWithPrec(5, 3), // 0.5% slash for an invalid relay
+		sdk.NewDecWithPrec(5, 2), // 5% of the slashed amount to the reporter
+		100_000,                  // jailed for 100,000 blocks
+		[]string{},
+	)
+}
+
+// Validate performs basic validation of Params.
+func (p Params) Validate() error {
+	if err := validatePositiveInt64(p.HeartbeatPeriodSeconds); err != nil {
+		return fmt.Errorf("heartbeat_period_seconds: %w", err)
+	}
+	if err := validatePositiveInt64(p.AssignmentWindowBlocks); err != nil {
+		return fmt.Errorf("assignment_window_blocks: %w", err)
+	}
+	if err := validateMissedDutyThreshold(p.MissedDutyThreshold); err != nil {
+		return fmt.Errorf("missed_duty_threshold: %w", err)
+	}
+	if err := validateFraction(p.SlashFractionMissed); err != nil {
+		return fmt.Errorf("slash_fraction_missed: %w", err)
+	}
+	if err := validateFraction(p.SlashFractionInvalid); err != nil {
+		return fmt.Errorf("slash_fraction_invalid: %w", err)
+	}
+	if err := validateFraction(p.ReporterRewardShare); err != nil {
+		return fmt.Errorf("reporter_reward_share: %w", err)
+	}
+	if err := validatePositiveInt64(p.JailWindowBlocks); err != nil {
+		return fmt.Errorf("jail_window_blocks: %w", err)
+	}
+	return validateBlockedRelayerAddresses(p.BlockedRelayerAddresses)
+}
+
+func validatePositiveInt64(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateMissedDutyThreshold(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateBlockedRelayerAddresses(i interface{}) error {
+	addrs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid blocked relayer address %q: %w", addr, err)
+		}
+	}
+	return nil
+}
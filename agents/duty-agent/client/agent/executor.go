@@ -0,0 +1,97 @@
+// Package agent provides the duty-agent's relayer execution backends: an
+// Executor is responsible for actually driving a cross-chain message from
+// origin to destination once the chain has assigned it to this validator.
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	dutytypes "sovereign/x/duty/types"
+)
+
+// Executor relays a single assigned duty. Implementations are free to shell
+// out to an external relayer binary, call into an embedded relayer library,
+// or talk to a sidecar process over RPC.
+type Executor interface {
+	// Relay drives the cross-chain message identified by (route, msgID) to
+	// completion. A nil error means the message was successfully relayed
+	// and the caller should submit MsgAckRelayed.
+	Relay(ctx context.Context, route dutytypes.Route, msgID uint64) error
+}
+
+// Backend selects which Executor implementation to construct.
+type Backend string
+
+const (
+	BackendHermes      Backend = "hermes"
+	BackendRly         Backend = "rly"
+	BackendProcessExec Backend = "process-exec"
+)
+
+// NewExecutor constructs the Executor for the given backend. bin is the path
+// to the relayer binary for the hermes, rly and process-exec backends.
+func NewExecutor(backend Backend, bin string) (Executor, error) {
+	switch backend {
+	case BackendHermes:
+		return &hermesExecutor{bin: bin}, nil
+	case BackendRly:
+		return &rlyExecutor{bin: bin}, nil
+	case BackendProcessExec, "":
+		return &processExecExecutor{bin: bin}, nil
+	default:
+		return nil, fmt.Errorf("unknown relayer executor backend %q", backend)
+	}
+}
+
+// hermesExecutor drives relaying via the `hermes` CLI's `tx packet-relay`
+// (IBC-style) command family.
+type hermesExecutor struct {
+	bin string
+}
+
+func (e *hermesExecutor) Relay(ctx context.Context, route dutytypes.Route, msgID uint64) error {
+	cmd := exec.CommandContext(ctx, e.bin, "tx", "packet-relay",
+		"--src-chain", route.Origin,
+		"--dst-chain", route.Destination,
+		"--packet-seq", fmt.Sprintf("%d", msgID),
+	)
+	return runRelayerCommand(cmd)
+}
+
+// rlyExecutor drives relaying via the `rly` CLI's `tx relay-packets` command.
+type rlyExecutor struct {
+	bin string
+}
+
+func (e *rlyExecutor) Relay(ctx context.Context, route dutytypes.Route, msgID uint64) error {
+	cmd := exec.CommandContext(ctx, e.bin, "tx", "relay-packets",
+		route.Origin+":"+route.Destination,
+		"--seq", fmt.Sprintf("%d", msgID),
+	)
+	return runRelayerCommand(cmd)
+}
+
+// processExecExecutor is the generic fallback: it shells out to an arbitrary
+// relayer binary with a `--route`/`--msg-id` convention, for operators who
+// run something other than hermes or rly.
+type processExecExecutor struct {
+	bin string
+}
+
+func (e *processExecExecutor) Relay(ctx context.Context, route dutytypes.Route, msgID uint64) error {
+	cmd := exec.CommandContext(ctx, e.bin,
+		"--route", route.Origin+"-"+route.Destination,
+		"--msg-id", fmt.Sprintf("%d", msgID),
+	)
+	return runRelayerCommand(cmd)
+}
+
+func runRelayerCommand(cmd *exec.Cmd) error {
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("relayer command failed: %w: %s", err, string(output))
+	}
+	return nil
+}
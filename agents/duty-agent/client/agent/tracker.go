@@ -0,0 +1,143 @@
+package agent
+
+import (
+	"sync"
+	"time"
+
+	dutytypes "sovereign/x/duty/types"
+)
+
+// dutyKey identifies a duty by (Route, MsgID) for deduping.
+type dutyKey struct {
+	origin      string
+	destination string
+	msgID       uint64
+}
+
+func keyFor(route dutytypes.Route, msgID uint64) dutyKey {
+	return dutyKey{origin: route.Origin, destination: route.Destination, msgID: msgID}
+}
+
+// attempt tracks a single duty's execution history. relayed and acked are
+// tracked separately: relayed means executor.Relay returned nil, acked means
+// MsgAckRelayed was actually submitted. A relay can succeed while the
+// subsequent ack fails, and the duty must still be retried until it is
+// acked — see MarkAcked.
+type attempt struct {
+	tries     int
+	lastTry   time.Time
+	nextRetry time.Time
+	relayed   bool
+	acked     bool
+}
+
+// DutyTracker dedupes duty assignments by (Route, MsgID), records execution
+// attempts, and backs off exponentially on failure so a stuck relay doesn't
+// get retried every tick. It also gates re-querying assignments from the
+// chain to once per period rather than on every tick.
+type DutyTracker struct {
+	mu            sync.Mutex
+	attempts      map[dutyKey]*attempt
+	baseBackoff   time.Duration
+	maxBackoff    time.Duration
+	lastQueriedAt time.Time
+	queryInterval time.Duration
+}
+
+// NewDutyTracker creates a DutyTracker. queryInterval controls how often
+// ShouldQuery allows a fresh assignment query (independent of the heartbeat
+// tick rate); baseBackoff/maxBackoff bound the retry backoff after a failed
+// relay attempt.
+func NewDutyTracker(queryInterval, baseBackoff, maxBackoff time.Duration) *DutyTracker {
+	return &DutyTracker{
+		attempts:      make(map[dutyKey]*attempt),
+		baseBackoff:   baseBackoff,
+		maxBackoff:    maxBackoff,
+		queryInterval: queryInterval,
+	}
+}
+
+// ShouldQuery reports whether enough time has passed since the last
+// assignment query to issue another one, and marks now as the new query
+// time if so.
+func (t *DutyTracker) ShouldQuery(now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Sub(t.lastQueriedAt) < t.queryInterval {
+		return false
+	}
+	t.lastQueriedAt = now
+	return true
+}
+
+// ShouldAttempt reports whether duty (route, msgID) is eligible to be
+// (re)executed right now: it hasn't been acked, and any backoff window from
+// a prior failed attempt has elapsed.
+func (t *DutyTracker) ShouldAttempt(route dutytypes.Route, msgID uint64, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.attempts[keyFor(route, msgID)]
+	if !ok {
+		return true
+	}
+	if a.acked {
+		return false
+	}
+	return !now.Before(a.nextRetry)
+}
+
+// RecordAttempt records the outcome of a relay attempt, scheduling the next
+// eligible retry time with exponential backoff on failure. A nil err only
+// marks the duty as relayed, not acked — ShouldAttempt keeps reporting true
+// until MarkAcked is called, so a relay that succeeds but whose ack fails
+// still gets retried instead of getting stuck unacked forever.
+func (t *DutyTracker) RecordAttempt(route dutytypes.Route, msgID uint64, now time.Time, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := keyFor(route, msgID)
+	a, ok := t.attempts[key]
+	if !ok {
+		a = &attempt{}
+		t.attempts[key] = a
+	}
+	a.tries++
+	a.lastTry = now
+
+	if err == nil {
+		a.relayed = true
+		a.nextRetry = now
+		return
+	}
+
+	backoff := t.baseBackoff << uint(a.tries-1)
+	if backoff > t.maxBackoff || backoff <= 0 {
+		backoff = t.maxBackoff
+	}
+	a.nextRetry = now.Add(backoff)
+}
+
+// MarkAcked records that MsgAckRelayed was successfully submitted for
+// (route, msgID), so ShouldAttempt stops returning true for it.
+func (t *DutyTracker) MarkAcked(route dutytypes.Route, msgID uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := keyFor(route, msgID)
+	a, ok := t.attempts[key]
+	if !ok {
+		a = &attempt{}
+		t.attempts[key] = a
+	}
+	a.acked = true
+}
+
+// Forget drops tracking state for (route, msgID), e.g. once the keeper has
+// confirmed the duty is no longer pending.
+func (t *DutyTracker) Forget(route dutytypes.Route, msgID uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.attempts, keyFor(route, msgID))
+}
@@ -2,12 +2,10 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
 	"os"
-	"os/exec"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -17,8 +15,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogoproto "github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc"
 
+	"duty-agent/client/agent"
 	// Assuming the duty module types are available in this path
 	dutymoduletypes "sovereign/x/duty/types"
 
@@ -41,40 +41,57 @@ func main() {
 		log.Fatalf("Invalid validator address: %v", err)
 	}
 
+	executor, err := agent.NewExecutor(agent.Backend(config.RelayerBackend), config.RelayerBin)
+	if err != nil {
+		log.Fatalf("Failed to construct relayer executor: %v", err)
+	}
+
+	// Duties are re-queried on their own cadence rather than on every
+	// heartbeat tick, and retried with backoff rather than hammered.
+	tracker := agent.NewDutyTracker(config.DutyQueryInterval, 5*time.Second, 5*time.Minute)
+
+	// Heartbeat cadence is governance policy, not an operator knob: querying
+	// it from chain params keeps every validator's agent in sync with
+	// whatever the DAO has configured, instead of risking desync against a
+	// locally set HEARTBEAT_PERIOD.
+	params, err := queryParams(config)
+	if err != nil {
+		log.Fatalf("Failed to query chain params: %v", err)
+	}
+	heartbeatPeriod := time.Duration(params.HeartbeatPeriodSeconds) * time.Second
+
+	// Seed the nonce counter from the last nonce the keeper actually
+	// accepted, so a restarted agent doesn't resubmit a nonce the keeper has
+	// already seen and reject every heartbeat as a replay until the
+	// in-process counter climbs back past it.
+	lastNonce, err := queryLastHeartbeatNonce(config, valAddr)
+	if err != nil {
+		log.Fatalf("Failed to query last accepted heartbeat nonce: %v", err)
+	}
+	heartbeatNonce = lastNonce
+
 	// --- Main Loop ---
 	log.Printf("Starting duty agent for validator %s", config.ValAddr)
-	log.Printf("Heartbeat period: %s", config.HeartbeatPeriod)
+	log.Printf("Heartbeat period: %s", heartbeatPeriod)
 
-	ticker := time.NewTicker(config.HeartbeatPeriod)
+	ticker := time.NewTicker(heartbeatPeriod)
 	defer ticker.Stop()
 
 	for range ticker.C {
 		log.Println("Ticker event: checking for duties and sending heartbeat...")
 
-		// --- Query for Assignments (Placeholder) ---
-		// In a real implementation, you would query the chain for assigned duties.
-		// For this example, we'll simulate finding a duty.
-		log.Println("Querying for duty assignments...")
-		// assignments, err := queryDuties(config, valAddr)
-		// if err != nil {
-		// 	log.Printf("Error querying duties: %v", err)
-		// 	continue
-		// }
-		// if len(assignments) == 0 {
-		// 	log.Println("No new duties assigned.")
-		// }
-
-		// --- Execute Relayer for each assignment (Placeholder) ---
-		// for _, duty := range assignments {
-		// 	log.Printf("Executing relayer for duty: MsgID %d on Route %s->%s", duty.MsgID, duty.Route.Origin, duty.Route.Destination)
-		// 	cmd := exec.Command(config.RelayerBin, "--route", duty.Route.Origin+"-"+duty.Route.Destination, "--msg-id", fmt.Sprintf("%d", duty.MsgID))
-		// 	output, err := cmd.CombinedOutput()
-		// 	if err != nil {
-		// 		log.Printf("Relayer execution failed for MsgID %d: %v. Output: %s", duty.MsgID, err, string(output))
-		// 	} else {
-		// 		log.Printf("Relayer executed successfully for MsgID %d. Output: %s", duty.MsgID, string(output))
-		// 	}
-		// }
+		if tracker.ShouldQuery(time.Now()) {
+			duties, err := queryDuties(config, valAddr)
+			if err != nil {
+				log.Printf("Error querying duties: %v", err)
+			} else if len(duties) == 0 {
+				log.Println("No new duties assigned.")
+			} else {
+				for _, duty := range duties {
+					executeDuty(config, valAddr, executor, tracker, duty, *dryRun)
+				}
+			}
+		}
 
 		// --- Send Heartbeat ---
 		if err := sendHeartbeat(config, valAddr, *dryRun); err != nil {
@@ -83,6 +100,31 @@ func main() {
 	}
 }
 
+// executeDuty relays a single assigned duty (subject to the tracker's
+// dedupe/backoff rules) and, on success, submits MsgAckRelayed so the
+// keeper retires it from the pending queue.
+func executeDuty(config Config, valAddr sdk.ValAddress, executor agent.Executor, tracker *agent.DutyTracker, duty dutymoduletypes.Duty, dryRun bool) {
+	now := time.Now()
+	if !tracker.ShouldAttempt(duty.Route, duty.MsgID, now) {
+		return
+	}
+
+	log.Printf("Executing relayer for duty: MsgID %d on Route %s->%s", duty.MsgID, duty.Route.Origin, duty.Route.Destination)
+	err := executor.Relay(context.Background(), duty.Route, duty.MsgID)
+	tracker.RecordAttempt(duty.Route, duty.MsgID, now, err)
+	if err != nil {
+		log.Printf("Relayer execution failed for MsgID %d: %v", duty.MsgID, err)
+		return
+	}
+	log.Printf("Relayer executed successfully for MsgID %d", duty.MsgID)
+
+	if err := ackRelayed(config, valAddr, duty, dryRun); err != nil {
+		log.Printf("Error acknowledging relayed duty MsgID %d: %v", duty.MsgID, err)
+		return
+	}
+	tracker.MarkAcked(duty.Route, duty.MsgID)
+}
+
 type Config struct {
 	SovereignRPC      string
 	SovereignGRPC     string
@@ -90,15 +132,37 @@ type Config struct {
 	ValAddr           string
 	RelayerKeyPath    string
 	RelayerBin        string
-	HeartbeatPeriod   time.Duration
+	RelayerBackend    string
+	DutyQueryInterval time.Duration
+}
+
+// heartbeatValidityBlocks is how many blocks past the height queried in
+// sendHeartbeat a HeartbeatPayload remains acceptable before the keeper
+// rejects it as expired.
+const heartbeatValidityBlocks = 20
+
+// heartbeatNonce is a process-local monotonic counter for
+// HeartbeatPayload.Nonce, seeded from queryLastHeartbeatNonce on startup so
+// a restarted agent resumes above the last nonce the keeper accepted
+// instead of replaying one it already rejects.
+var heartbeatNonce uint64
+
+func nextNonce() uint64 {
+	heartbeatNonce++
+	return heartbeatNonce
 }
 
 func readEnvConfig() Config {
-	periodStr := os.Getenv("HEARTBEAT_PERIOD")
-	period, err := time.ParseDuration(periodStr)
+	dutyQueryIntervalStr := os.Getenv("DUTY_QUERY_INTERVAL")
+	dutyQueryInterval, err := time.ParseDuration(dutyQueryIntervalStr)
 	if err != nil {
-		log.Printf("Invalid HEARTBEAT_PERIOD '%s', defaulting to 30s. Error: %v", periodStr, err)
-		period = 30 * time.Second
+		log.Printf("Invalid DUTY_QUERY_INTERVAL '%s', defaulting to 1m. Error: %v", dutyQueryIntervalStr, err)
+		dutyQueryInterval = time.Minute
+	}
+
+	relayerBackend := os.Getenv("RELAYER_BACKEND")
+	if relayerBackend == "" {
+		relayerBackend = string(agent.BackendProcessExec)
 	}
 
 	return Config{
@@ -108,24 +172,36 @@ func readEnvConfig() Config {
 		ValAddr:           os.Getenv("VAL_ADDR"),
 		RelayerKeyPath:    os.Getenv("RELAYER_KEY_PATH"),
 		RelayerBin:        os.Getenv("RELAYER_BIN"),
-		HeartbeatPeriod:   period,
+		RelayerBackend:    relayerBackend,
+		DutyQueryInterval: dutyQueryInterval,
 	}
 }
 
 func validateConfig(c Config) error {
-	if c.SovereignRPC == "" { return fmt.Errorf("SOVEREIGN_RPC must be set") }
-	if c.SovereignGRPC == "" { return fmt.Errorf("SOVEREIGN_GRPC must be set") }
-	if c.SovereignChainID == "" { return fmt.Errorf("SOVEREIGN_CHAIN_ID must be set") }
-	if c.ValAddr == "" { return fmt.Errorf("VAL_ADDR must be set") }
-	if c.RelayerKeyPath == "" { return fmt.Errorf("RELAYER_KEY_PATH must be set") }
-	if c.RelayerBin == "" { return fmt.Errorf("RELAYER_BIN must be set") }
+	if c.SovereignRPC == "" {
+		return fmt.Errorf("SOVEREIGN_RPC must be set")
+	}
+	if c.SovereignGRPC == "" {
+		return fmt.Errorf("SOVEREIGN_GRPC must be set")
+	}
+	if c.SovereignChainID == "" {
+		return fmt.Errorf("SOVEREIGN_CHAIN_ID must be set")
+	}
+	if c.ValAddr == "" {
+		return fmt.Errorf("VAL_ADDR must be set")
+	}
+	if c.RelayerKeyPath == "" {
+		return fmt.Errorf("RELAYER_KEY_PATH must be set")
+	}
+	if c.RelayerBin == "" {
+		return fmt.Errorf("RELAYER_BIN must be set")
+	}
 	return nil
 }
 
-// queryDuties would connect to gRPC and query the x/duty module.
-// This is a placeholder as it requires the actual proto definitions to be compiled.
+// queryDuties connects to the chain's gRPC endpoint and asks the x/duty
+// module's QueryServer for this validator's pending duty assignments.
 func queryDuties(config Config, valAddr sdk.ValAddress) ([]dutymoduletypes.Duty, error) {
-	// Setup gRPC connection
 	grpcConn, err := grpc.Dial(
 		config.SovereignGRPC,
 		grpc.WithInsecure(), // Use secure options in production
@@ -145,47 +221,157 @@ func queryDuties(config Config, valAddr sdk.ValAddress) ([]dutymoduletypes.Duty,
 	return res.Duties, nil
 }
 
+// queryParams connects to the chain's gRPC endpoint and asks the x/duty
+// module's QueryServer for its current governance-set Params.
+func queryParams(config Config) (dutymoduletypes.Params, error) {
+	grpcConn, err := grpc.Dial(
+		config.SovereignGRPC,
+		grpc.WithInsecure(), // Use secure options in production
+	)
+	if err != nil {
+		return dutymoduletypes.Params{}, fmt.Errorf("failed to dial gRPC: %w", err)
+	}
+	defer grpcConn.Close()
+
+	queryClient := dutymoduletypes.NewQueryClient(grpcConn)
+	res, err := queryClient.Params(context.Background(), &dutymoduletypes.QueryParamsRequest{})
+	if err != nil {
+		return dutymoduletypes.Params{}, fmt.Errorf("params query failed: %w", err)
+	}
+	return res.Params, nil
+}
+
+// queryLastHeartbeatNonce connects to the chain's gRPC endpoint and asks the
+// x/duty module's QueryServer for valAddr's last accepted heartbeat nonce.
+func queryLastHeartbeatNonce(config Config, valAddr sdk.ValAddress) (uint64, error) {
+	grpcConn, err := grpc.Dial(
+		config.SovereignGRPC,
+		grpc.WithInsecure(), // Use secure options in production
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to dial gRPC: %w", err)
+	}
+	defer grpcConn.Close()
+
+	queryClient := dutymoduletypes.NewQueryClient(grpcConn)
+	res, err := queryClient.HeartbeatNonce(context.Background(), &dutymoduletypes.QueryHeartbeatNonceRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("heartbeat nonce query failed: %w", err)
+	}
+	return res.Nonce, nil
+}
+
+// queryLatestHeight asks the sovereign chain's RPC endpoint for its latest
+// committed block height, used to set HeartbeatPayload.ExpiresAtHeight.
+func queryLatestHeight(config Config) (int64, error) {
+	rpcClient, err := client.NewClientFromNode(config.SovereignRPC)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create RPC client: %w", err)
+	}
+	status, err := rpcClient.Status(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("failed to query node status: %w", err)
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// ackRelayed submits a MsgAckRelayed for duty so the keeper retires it from
+// the pending queue.
+func ackRelayed(config Config, valAddr sdk.ValAddress, duty dutymoduletypes.Duty, dryRun bool) error {
+	kr, relayerKey, err := loadRelayerKeyring(config)
+	if err != nil {
+		return err
+	}
+	relayerAddr, err := relayerKey.GetAddress()
+	if err != nil {
+		return fmt.Errorf("failed to get relayer address: %w", err)
+	}
+
+	msg := dutymoduletypes.NewMsgAckRelayed(duty.Route, duty.MsgID, valAddr, relayerAddr)
+	return signAndBroadcast(config, kr, relayerKey, msg, dryRun)
+}
+
 func sendHeartbeat(config Config, valAddr sdk.ValAddress, dryRun bool) error {
 	log.Println("Building heartbeat transaction...")
-	// In a real implementation, these heights would be queried from respective chains.
-	originHeights := map[string]uint64{
-		"light-1": 123,
-		"other-2": 456,
+
+	latestHeight, err := queryLatestHeight(config)
+	if err != nil {
+		return fmt.Errorf("failed to query latest height: %w", err)
 	}
-	originHeightsJSON, err := json.Marshal(originHeights)
+
+	// In a real implementation, BlockHash/LightClientProof would be pulled
+	// from each attested chain's own light client rather than hardcoded.
+	attestations := map[string]dutymoduletypes.HeightAttestation{
+		"light-1": {ChainID: "light-1", Height: 123, BlockHash: []byte("light-1-hash"), Timestamp: time.Now()},
+		"other-2": {ChainID: "other-2", Height: 456, BlockHash: []byte("other-2-hash"), Timestamp: time.Now()},
+	}
+
+	kr, relayerKey, err := loadRelayerKeyring(config)
 	if err != nil {
-		return fmt.Errorf("failed to marshal origin heights: %w", err)
+		return err
 	}
 
-	// For signing, we'll create a temporary in-memory keyring and import the key.
-	// This avoids needing a home directory or complex config.
+	// MsgHeartbeat.Sig is a signature over the canonical marshaled bytes of the
+	// HeartbeatPayload itself, not over the outer tx's SignDoc — the keeper
+	// verifies it independently of however the tx is signed.
+	payload := dutymoduletypes.HeartbeatPayload{
+		ValAddr:         valAddr,
+		Attestations:    attestations,
+		Nonce:           nextNonce(),
+		ExpiresAtHeight: latestHeight + heartbeatValidityBlocks,
+	}
+	payloadBytes, err := gogoproto.Marshal(&payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal heartbeat payload: %w", err)
+	}
+	sig, _, err := kr.Sign("relayer", payloadBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return fmt.Errorf("failed to sign heartbeat payload: %w", err)
+	}
+
+	relayerAddr, err := relayerKey.GetAddress()
+	if err != nil {
+		return fmt.Errorf("failed to get relayer address: %w", err)
+	}
+
+	msg := dutymoduletypes.NewMsgHeartbeat(valAddr, payload, sig, relayerAddr)
+	return signAndBroadcast(config, kr, relayerKey, msg, dryRun)
+}
+
+// loadRelayerKeyring creates a temporary in-memory keyring and imports the
+// relayer key from config.RelayerKeyPath. This avoids needing a home
+// directory or complex config.
+func loadRelayerKeyring(config Config) (keyring.Keyring, *keyring.Record, error) {
 	kr, err := keyring.New("duty-agent", keyring.BackendMemory, "", nil)
 	if err != nil {
-		return fmt.Errorf("failed to create keyring: %w", err)
+		return nil, nil, fmt.Errorf("failed to create keyring: %w", err)
 	}
 	keyBytes, err := os.ReadFile(config.RelayerKeyPath)
 	if err != nil {
-		return fmt.Errorf("failed to read relayer key: %w", err)
+		return nil, nil, fmt.Errorf("failed to read relayer key: %w", err)
 	}
 	// Assuming the key is armored (like `gaiad keys export`)
 	if err := kr.ImportPrivKey("relayer", string(keyBytes), "password"); err != nil {
-		return fmt.Errorf("failed to import private key: %w", err)
+		return nil, nil, fmt.Errorf("failed to import private key: %w", err)
 	}
 
 	relayerKey, err := kr.Key("relayer")
 	if err != nil {
-		return fmt.Errorf("failed to get key from keyring: %w", err)
+		return nil, nil, fmt.Errorf("failed to get key from keyring: %w", err)
 	}
+	return kr, relayerKey, nil
+}
 
-	// This is a simplified signature. A real implementation should sign a canonical representation.
-	sig, _, err := kr.Sign("relayer", []byte(originHeightsJSON), signing.SignMode_SIGN_MODE_DIRECT)
+// signAndBroadcast builds msg into a tx, signs it with SIGN_MODE_DIRECT
+// using the relayer key, and either prints it (dryRun) or broadcasts it.
+func signAndBroadcast(config Config, kr keyring.Keyring, relayerKey *keyring.Record, msg sdk.Msg, dryRun bool) error {
+	relayerAddr, err := relayerKey.GetAddress()
 	if err != nil {
-		return fmt.Errorf("failed to sign heartbeat data: %w", err)
+		return fmt.Errorf("failed to get relayer address: %w", err)
 	}
-	
-	msg := dutymoduletypes.NewMsgHeartbeat(valAddr, string(originHeightsJSON), sig)
 
-	// --- Transaction Building & Broadcasting ---
 	encodingConfig := app.MakeEncodingConfig() // Using the app's encoding config
 	clientCtx := client.Context{}.
 		WithClient(nil). // We don't need a client for this part
@@ -193,7 +379,7 @@ func sendHeartbeat(config Config, valAddr sdk.ValAddress, dryRun bool) error {
 		WithTxConfig(encodingConfig.TxConfig).
 		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
 		WithKeyring(kr).
-		WithFromAddress(relayerKey.GetAddress()).
+		WithFromAddress(relayerAddr).
 		WithFromName("relayer")
 
 	txf := tx.NewFactoryCLI(clientCtx, nil).
@@ -222,7 +408,7 @@ func sendHeartbeat(config Config, valAddr sdk.ValAddress, dryRun bool) error {
 		fmt.Println(string(txJSON))
 		return nil
 	}
-	
+
 	// Create a real client context for broadcasting
 	rpcClient, err := client.NewClientFromNode(config.SovereignRPC)
 	if err != nil {
@@ -235,12 +421,12 @@ func sendHeartbeat(config Config, valAddr sdk.ValAddress, dryRun bool) error {
 	if err != nil {
 		return fmt.Errorf("failed to encode tx to bytes: %w", err)
 	}
-	
+
 	res, err := clientCtx.BroadcastTx(txBytes)
 	if err != nil {
 		return fmt.Errorf("broadcast failed: %w", err)
 	}
 
-	log.Printf("Heartbeat sent successfully! TxHash: %s", res.TxHash)
+	log.Printf("Tx broadcast successfully! TxHash: %s", res.TxHash)
 	return nil
 }